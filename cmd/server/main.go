@@ -0,0 +1,139 @@
+package main
+
+import (
+	"html/template"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/wato787/go-todo/domain"
+	"github.com/wato787/go-todo/infra/repository"
+	"github.com/wato787/go-todo/interface/handler"
+	"github.com/wato787/go-todo/interface/middleware"
+	"github.com/wato787/go-todo/usecase"
+)
+
+// newTodoRepository はSTORAGE環境変数に応じてリポジトリ実装を選択します。
+// "ent" が指定された場合はDBへ永続化し、"event" が指定された場合はイベントログへ永続化し、
+// 未指定またはそれ以外の場合はメモリ実装にフォールバックします。
+func newTodoRepository() domain.TodoRepository {
+	switch os.Getenv("STORAGE") {
+	case "ent":
+		repo, err := repository.NewEntTodoRepository()
+		if err != nil {
+			log.Fatalf("entリポジトリの初期化に失敗しました: %v", err)
+		}
+		return repo
+	case "event":
+		eventsPath := os.Getenv("EVENTS_PATH")
+		if eventsPath == "" {
+			eventsPath = "events.jsonl"
+		}
+		repo, err := repository.NewEventSourcedTodoRepository(eventsPath, snapshotInterval())
+		if err != nil {
+			log.Fatalf("イベントソーシングリポジトリの初期化に失敗しました: %v", err)
+		}
+		return repo
+	default:
+		return repository.NewInMemoryTodoRepository()
+	}
+}
+
+// newUserRepository はSTORAGE環境変数に応じてリポジトリ実装を選択します。
+// "ent" が指定された場合はTodoと同じくDBへ永続化します。"event" や未指定の場合はイベントソーシングによる
+// ユーザー永続化に対応していないため、メモリ実装にフォールバックします(登録したアカウントは再起動で失われます)。
+func newUserRepository() domain.UserRepository {
+	switch os.Getenv("STORAGE") {
+	case "ent":
+		repo, err := repository.NewEntUserRepository()
+		if err != nil {
+			log.Fatalf("entリポジトリの初期化に失敗しました: %v", err)
+		}
+		return repo
+	default:
+		return repository.NewInMemoryUserRepository()
+	}
+}
+
+// snapshotInterval はSNAPSHOT_INTERVAL_SECONDS環境変数(秒)からスナップショット取得間隔を決定します。
+// 未設定または不正な値の場合はスナップショットを無効化します
+func snapshotInterval() time.Duration {
+	seconds, err := strconv.Atoi(os.Getenv("SNAPSHOT_INTERVAL_SECONDS"))
+	if err != nil || seconds <= 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// jwtSecret はJWT_SECRET環境変数からJWTの署名鍵を取得します。未設定の場合は開発用の既定値を使います
+func jwtSecret() string {
+	if secret := os.Getenv("JWT_SECRET"); secret != "" {
+		return secret
+	}
+	return "dev-secret-change-me"
+}
+
+func main() {
+	// Ginのデフォルトルーターを作成
+	router := gin.Default()
+
+	// リポジトリ・サービス・ハンドラーの初期化
+	secret := jwtSecret()
+
+	todoRepo := newTodoRepository()
+	todoService := usecase.NewTodoService(todoRepo)
+	todoHandler := handler.NewTodoHandler(todoService).WithTemplates(template.Must(template.ParseGlob("web/templates/*.html")))
+
+	userRepo := newUserRepository()
+	authService := usecase.NewAuthService(userRepo, secret)
+	authHandler := handler.NewAuthHandler(authService).WithTemplates(template.Must(template.ParseGlob("web/templates/*.html")))
+
+	// APIエンドポイントの設定
+	api := router.Group("/api")
+	{
+		auth := api.Group("/auth")
+		{
+			auth.POST("/register", authHandler.Register)
+			auth.POST("/login", authHandler.Login)
+		}
+
+		todos := api.Group("/todos", middleware.AuthRequired(secret))
+		{
+			todos.GET("", todoHandler.GetAllTodos)
+			todos.POST("", todoHandler.CreateTodo)
+			todos.GET("/:id", todoHandler.GetTodo)
+			todos.PUT("/:id", todoHandler.UpdateTodo)
+			todos.DELETE("/:id", todoHandler.DeleteTodo)
+		}
+
+		events := api.Group("/events", middleware.AuthRequired(secret))
+		{
+			events.GET("", todoHandler.GetEvents)
+		}
+	}
+
+	// HTMXフロントエンド向けのログイン・登録画面。ここはAuthRequiredの対象外
+	router.GET("/login", authHandler.LoginPage)
+	router.POST("/login", authHandler.LoginForm)
+	router.GET("/register", authHandler.RegisterPage)
+	router.POST("/register", authHandler.RegisterForm)
+	router.GET("/logout", authHandler.Logout)
+
+	// HTMXフロントエンド向けのHTML画面。同じハンドラ群をAPIとして共有する
+	router.GET("/", middleware.AuthRequired(secret), todoHandler.Index)
+	htmlTodos := router.Group("/todos", middleware.AuthRequired(secret))
+	{
+		htmlTodos.POST("", todoHandler.CreateTodo)
+		htmlTodos.PUT("/:id/toggle", todoHandler.ToggleTodo)
+		htmlTodos.DELETE("/:id", todoHandler.DeleteTodo)
+	}
+
+	// サーバーの起動
+	log.Println("サーバーを起動しています... http://localhost:8080")
+	if err := router.Run(":8080"); err != nil {
+		log.Fatalf("サーバーの起動に失敗しました: %v", err)
+	}
+}
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"io"
+	"time"
+)
+
+// Todo はTODOアイテムを表すドメインモデルです
+type Todo struct {
+	ID        uint      `json:"id"`
+	UserID    uint      `json:"-"`
+	Title     string    `json:"title" form:"title" binding:"required"`
+	Completed bool      `json:"completed" form:"completed"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TodoFilter はFindAllの絞り込み・並び替え・ページングの条件です
+type TodoFilter struct {
+	// Keyword はTitleに対する部分一致検索のキーワードです
+	Keyword string
+	// Completed が非nilの場合、完了状態で絞り込みます
+	Completed *bool
+	// Limit は取得件数の上限です。0以下の場合は上限なしとして扱います
+	Limit int
+	// Offset はスキップする件数です
+	Offset int
+	// SortBy は並び替え対象のフィールドです (created_at|updated_at|title)
+	SortBy string
+	// SortDesc が true の場合は降順に並び替えます
+	SortDesc bool
+}
+
+// TodoPage はページング済みのTodo一覧を表します
+type TodoPage struct {
+	Items  []Todo
+	Total  int
+	Limit  int
+	Offset int
+}
+
+// TodoRepository はTODOの永続化を行うリポジトリのインターフェースです。
+// infra層がこのインターフェースを実装し、usecase層はこれにのみ依存します。
+// 全ての操作はuserIDで所有者を絞り込み、他人のTodoには到達できないようにします。
+type TodoRepository interface {
+	FindAll(userID uint, filter TodoFilter) (TodoPage, error)
+	FindByID(userID, id uint) (Todo, error)
+	Create(todo Todo) (Todo, error)
+	Update(userID, id uint, todo Todo) (Todo, error)
+	Delete(userID, id uint) error
+}
+
+// EventSource はTodoRepositoryが任意で実装するインターフェースです。
+// イベントソーシングで永続化するリポジトリは、userIDが所有する変更履歴のみをwへストリームできます
+type EventSource interface {
+	StreamEvents(w io.Writer, userID uint) error
+}
@@ -0,0 +1,18 @@
+package domain
+
+import "time"
+
+// User は認証されるアカウントを表すドメインモデルです
+type User struct {
+	ID           uint      `json:"id"`
+	Email        string    `json:"email"`
+	PasswordHash string    `json:"-"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// UserRepository はUserの永続化を行うリポジトリのインターフェースです
+type UserRepository interface {
+	FindByEmail(email string) (User, error)
+	FindByID(id uint) (User, error)
+	Create(user User) (User, error)
+}
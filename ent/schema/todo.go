@@ -0,0 +1,35 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Todo はTODOアイテムのentスキーマです
+type Todo struct {
+	ent.Schema
+}
+
+// Fields はTodoのフィールドを定義します
+func (Todo) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.String("title").
+			NotEmpty(),
+		field.Bool("completed").
+			Default(false),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges はTodoのエッジを定義します(現時点ではなし)
+func (Todo) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// User は認証されるアカウントのentスキーマです
+type User struct {
+	ent.Schema
+}
+
+// Fields はUserのフィールドを定義します
+func (User) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("email").
+			NotEmpty().
+			Unique(),
+		field.String("password_hash").
+			NotEmpty().
+			Sensitive(),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+	}
+}
+
+// Edges はUserのエッジを定義します(現時点ではなし)
+func (User) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,88 @@
+package eventstore
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/wato787/go-todo/domain"
+)
+
+// EventType はTodoに対する操作の種類を表します
+type EventType string
+
+const (
+	// EventTodoCreated はTodoが作成されたことを表すイベントです
+	EventTodoCreated EventType = "TodoCreated"
+	// EventTodoUpdated はTodoが更新されたことを表すイベントです
+	EventTodoUpdated EventType = "TodoUpdated"
+	// EventTodoCompleted はTodoが完了にされたことを表すイベントです
+	EventTodoCompleted EventType = "TodoCompleted"
+	// EventTodoDeleted はTodoが削除されたことを表すイベントです
+	EventTodoDeleted EventType = "TodoDeleted"
+)
+
+// Event はTodoに対する1回の操作を表すイベントです。append-onlyのログに1行1イベントとして書き出されます
+type Event struct {
+	Type       EventType   `json:"type"`
+	Todo       domain.Todo `json:"todo"`
+	OccurredAt time.Time   `json:"occurred_at"`
+}
+
+// persistedTodo はイベントログ・スナップショットに書き出すTodoの形です。domain.TodoはUserIDを
+// json:"-"でタグ付けしAPIレスポンスから隠していますが、そのタグのままイベントログへ書き出すと
+// user_idが失われ、リプレイ後は全Todoの所有者が0になってしまいます。そのためpersistedTodoだけ
+// user_idを明示的にJSONへ含めます
+type persistedTodo struct {
+	ID        uint      `json:"id"`
+	UserID    uint      `json:"user_id"`
+	Title     string    `json:"title"`
+	Completed bool      `json:"completed"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func newPersistedTodo(t domain.Todo) persistedTodo {
+	return persistedTodo{
+		ID:        t.ID,
+		UserID:    t.UserID,
+		Title:     t.Title,
+		Completed: t.Completed,
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
+	}
+}
+
+func (t persistedTodo) toDomain() domain.Todo {
+	return domain.Todo{
+		ID:        t.ID,
+		UserID:    t.UserID,
+		Title:     t.Title,
+		Completed: t.Completed,
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
+	}
+}
+
+// eventJSON はEventのJSON表現です。TodoをpersistedTodo経由で(un)marshalすることでuser_idを残します
+type eventJSON struct {
+	Type       EventType     `json:"type"`
+	Todo       persistedTodo `json:"todo"`
+	OccurredAt time.Time     `json:"occurred_at"`
+}
+
+// MarshalJSON はTodoをpersistedTodo経由でエンコードし、user_idを含めます
+func (e Event) MarshalJSON() ([]byte, error) {
+	return json.Marshal(eventJSON{Type: e.Type, Todo: newPersistedTodo(e.Todo), OccurredAt: e.OccurredAt})
+}
+
+// UnmarshalJSON はpersistedTodo経由でTodoをデコードし、user_idを復元します
+func (e *Event) UnmarshalJSON(data []byte) error {
+	var aux eventJSON
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	e.Type = aux.Type
+	e.Todo = aux.Todo.toDomain()
+	e.OccurredAt = aux.OccurredAt
+	return nil
+}
@@ -0,0 +1,210 @@
+package eventstore
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/wato787/go-todo/domain"
+)
+
+// Snapshot はある時点でのTodo一覧とnextIDをまとめた状態です。
+// 起動時のリプレイを短縮するためにイベントログと併せて使われます
+type Snapshot struct {
+	Todos  map[uint]domain.Todo
+	NextID uint
+}
+
+// snapshotJSON はSnapshotのJSON表現です。Eventと同様、TodosはpersistedTodo経由で
+// (un)marshalしてuser_idを残します
+type snapshotJSON struct {
+	Todos  map[uint]persistedTodo `json:"todos"`
+	NextID uint                   `json:"next_id"`
+}
+
+// MarshalJSON はTodosをpersistedTodo経由でエンコードし、user_idを含めます
+func (s Snapshot) MarshalJSON() ([]byte, error) {
+	todos := make(map[uint]persistedTodo, len(s.Todos))
+	for id, todo := range s.Todos {
+		todos[id] = newPersistedTodo(todo)
+	}
+	return json.Marshal(snapshotJSON{Todos: todos, NextID: s.NextID})
+}
+
+// UnmarshalJSON はpersistedTodo経由でTodosをデコードし、user_idを復元します
+func (s *Snapshot) UnmarshalJSON(data []byte) error {
+	var aux snapshotJSON
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	todos := make(map[uint]domain.Todo, len(aux.Todos))
+	for id, todo := range aux.Todos {
+		todos[id] = todo.toDomain()
+	}
+	s.Todos = todos
+	s.NextID = aux.NextID
+	return nil
+}
+
+// Store はappend-onlyのイベントログをファイルに永続化します
+type Store struct {
+	mutex        sync.Mutex
+	logPath      string
+	snapshotPath string
+}
+
+// NewStore はlogPathのイベントログを使うStoreを作成します。ファイルが存在しない場合は新規に作成します
+func NewStore(logPath string) (*Store, error) {
+	file, err := os.OpenFile(logPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("イベントログを開けませんでした: %w", err)
+	}
+	defer file.Close()
+
+	return &Store{
+		logPath:      logPath,
+		snapshotPath: logPath + ".snapshot",
+	}, nil
+}
+
+// LoadSnapshot はスナップショットファイルが存在すればそれを読み込みます。存在しない場合はnilを返します
+func (s *Store) LoadSnapshot() (*Snapshot, error) {
+	data, err := os.ReadFile(s.snapshotPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("スナップショットの読み込みに失敗しました: %w", err)
+	}
+
+	var snapshot Snapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("スナップショットのパースに失敗しました: %w", err)
+	}
+	return &snapshot, nil
+}
+
+// Replay はイベントログを先頭から読み、1件ずつapplyに渡します
+func (s *Store) Replay(apply func(Event)) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	file, err := os.Open(s.logPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("イベントログを開けませんでした: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var event Event
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			return fmt.Errorf("イベントのパースに失敗しました: %w", err)
+		}
+		apply(event)
+	}
+	return scanner.Err()
+}
+
+// Append はeventを1行のJSONとしてログに追記します
+func (s *Store) Append(event Event) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	file, err := os.OpenFile(s.logPath, os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("イベントログを開けませんでした: %w", err)
+	}
+	defer file.Close()
+
+	line, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("イベントのエンコードに失敗しました: %w", err)
+	}
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("イベントログへの書き込みに失敗しました: %w", err)
+	}
+	return nil
+}
+
+// Snapshot は現在の状態をスナップショットファイルに書き出し、イベントログを空に切り詰めます
+func (s *Store) Snapshot(todos map[uint]domain.Todo, nextID uint) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	data, err := json.Marshal(Snapshot{Todos: todos, NextID: nextID})
+	if err != nil {
+		return fmt.Errorf("スナップショットのエンコードに失敗しました: %w", err)
+	}
+
+	tmpPath := s.snapshotPath + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0o644); err != nil {
+		return fmt.Errorf("スナップショットの書き込みに失敗しました: %w", err)
+	}
+	if err := os.Rename(tmpPath, s.snapshotPath); err != nil {
+		return fmt.Errorf("スナップショットの置き換えに失敗しました: %w", err)
+	}
+
+	if err := os.Truncate(s.logPath, 0); err != nil {
+		return fmt.Errorf("イベントログの切り詰めに失敗しました: %w", err)
+	}
+	return nil
+}
+
+// StartSnapshotLoop はintervalごとにsnapshotを呼び出すゴルーチンを起動します。
+// snapshotは状態の取得とStore.Snapshotの呼び出しを1つのロックの下でアトミックに行う必要があります。
+// そうしないと、状態取得とログの切り詰めの間に追記されたイベントが失われます
+func (s *Store) StartSnapshotLoop(interval time.Duration, snapshot func() error) {
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			if err := snapshot(); err != nil {
+				fmt.Fprintf(os.Stderr, "スナップショットの作成に失敗しました: %v\n", err)
+			}
+		}
+	}()
+}
+
+// StreamTo はイベントログのうちuserIDが所有するTodoのイベントのみをwに書き出します。
+// 外部からの購読(GET /api/events)に使われ、他人のTodoが漏れないようフィルタします
+func (s *Store) StreamTo(w io.Writer, userID uint) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	file, err := os.Open(s.logPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("イベントログを開けませんでした: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		var event Event
+		if err := json.Unmarshal(line, &event); err != nil {
+			return fmt.Errorf("イベントのパースに失敗しました: %w", err)
+		}
+		if event.Todo.UserID != userID {
+			continue
+		}
+		if _, err := w.Write(append(append([]byte{}, line...), '\n')); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
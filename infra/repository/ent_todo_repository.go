@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/wato787/go-todo/domain"
+	"github.com/wato787/go-todo/ent"
+	"github.com/wato787/go-todo/ent/todo"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// EntTodoRepository はentクライアントを使ってTODOを永続化するリポジトリです
+type EntTodoRepository struct {
+	client *ent.Client
+}
+
+// NewEntTodoRepository はDB_DRIVER/DB_SOURCE環境変数に従ってentクライアントに接続し、
+// スキーマを自動マイグレーションした上でEntTodoRepositoryを作成します。
+func NewEntTodoRepository() (*EntTodoRepository, error) {
+	driver := envOrDefault("DB_DRIVER", "sqlite3")
+	source := envOrDefault("DB_SOURCE", "file:todo.db?_fk=1")
+
+	client, err := ent.Open(driver, source)
+	if err != nil {
+		return nil, fmt.Errorf("DBへの接続に失敗しました: %w", err)
+	}
+
+	if err := client.Schema.Create(context.Background()); err != nil {
+		return nil, fmt.Errorf("マイグレーションに失敗しました: %w", err)
+	}
+
+	return &EntTodoRepository{client: client}, nil
+}
+
+func envOrDefault(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+// FindAll はuserIDが所有するTodoのうち、filterの条件で絞り込み・並び替え・ページングした一覧を取得します
+func (r *EntTodoRepository) FindAll(userID uint, filter domain.TodoFilter) (domain.TodoPage, error) {
+	ctx := context.Background()
+	query := r.client.Todo.Query().Where(todo.UserIDEQ(int(userID)))
+
+	if filter.Completed != nil {
+		query = query.Where(todo.CompletedEQ(*filter.Completed))
+	}
+	if filter.Keyword != "" {
+		query = query.Where(todo.TitleContainsFold(filter.Keyword))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return domain.TodoPage{}, fmt.Errorf("件数の取得に失敗しました: %w", err)
+	}
+
+	switch filter.SortBy {
+	case "updated_at":
+		query = orderBy(query, todo.FieldUpdatedAt, filter.SortDesc)
+	case "title":
+		query = orderBy(query, todo.FieldTitle, filter.SortDesc)
+	default:
+		query = orderBy(query, todo.FieldCreatedAt, filter.SortDesc)
+	}
+
+	if filter.Offset > 0 {
+		query = query.Offset(filter.Offset)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	rows, err := query.All(ctx)
+	if err != nil {
+		return domain.TodoPage{}, fmt.Errorf("一覧の取得に失敗しました: %w", err)
+	}
+
+	todos := make([]domain.Todo, 0, len(rows))
+	for _, row := range rows {
+		todos = append(todos, toDomainTodo(row))
+	}
+
+	return domain.TodoPage{
+		Items:  todos,
+		Total:  total,
+		Limit:  filter.Limit,
+		Offset: filter.Offset,
+	}, nil
+}
+
+// orderBy はdescに応じて昇順・降順のOrderOptionを適用します
+func orderBy(query *ent.TodoQuery, field string, desc bool) *ent.TodoQuery {
+	if desc {
+		return query.Order(ent.Desc(field))
+	}
+	return query.Order(ent.Asc(field))
+}
+
+// FindByID はuserIDが所有する指定されたIDのTodoを取得します
+func (r *EntTodoRepository) FindByID(userID, id uint) (domain.Todo, error) {
+	row, err := r.client.Todo.Query().
+		Where(todo.IDEQ(int(id)), todo.UserIDEQ(int(userID))).
+		Only(context.Background())
+	if err != nil {
+		return domain.Todo{}, errors.New("todo not found")
+	}
+	return toDomainTodo(row), nil
+}
+
+// Create は新しいTodoを作成します
+func (r *EntTodoRepository) Create(todo domain.Todo) (domain.Todo, error) {
+	row, err := r.client.Todo.Create().
+		SetUserID(int(todo.UserID)).
+		SetTitle(todo.Title).
+		SetCompleted(todo.Completed).
+		Save(context.Background())
+	if err != nil {
+		return domain.Todo{}, fmt.Errorf("Todoの作成に失敗しました: %w", err)
+	}
+	return toDomainTodo(row), nil
+}
+
+// Update はuserIDが所有する指定されたIDのTodoを更新します
+func (r *EntTodoRepository) Update(userID, id uint, updated domain.Todo) (domain.Todo, error) {
+	if _, err := r.FindByID(userID, id); err != nil {
+		return domain.Todo{}, err
+	}
+
+	update := r.client.Todo.UpdateOneID(int(id)).
+		SetCompleted(updated.Completed)
+	if updated.Title != "" {
+		update = update.SetTitle(updated.Title)
+	}
+
+	row, err := update.Save(context.Background())
+	if err != nil {
+		return domain.Todo{}, errors.New("todo not found")
+	}
+	return toDomainTodo(row), nil
+}
+
+// Delete はuserIDが所有する指定されたIDのTodoを削除します
+func (r *EntTodoRepository) Delete(userID, id uint) error {
+	if _, err := r.FindByID(userID, id); err != nil {
+		return err
+	}
+
+	if err := r.client.Todo.DeleteOneID(int(id)).Exec(context.Background()); err != nil {
+		return errors.New("todo not found")
+	}
+	return nil
+}
+
+// toDomainTodo はentが生成したTodoモデルをドメインのTodo構造体に変換します
+func toDomainTodo(row *ent.Todo) domain.Todo {
+	return domain.Todo{
+		ID:        uint(row.ID),
+		UserID:    uint(row.UserID),
+		Title:     row.Title,
+		Completed: row.Completed,
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/wato787/go-todo/domain"
+	"github.com/wato787/go-todo/ent"
+	"github.com/wato787/go-todo/ent/user"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// EntUserRepository はentクライアントを使ってUserを永続化するリポジトリです
+type EntUserRepository struct {
+	client *ent.Client
+}
+
+// NewEntUserRepository はDB_DRIVER/DB_SOURCE環境変数に従ってentクライアントに接続し、
+// スキーマを自動マイグレーションした上でEntUserRepositoryを作成します。
+func NewEntUserRepository() (*EntUserRepository, error) {
+	driver := envOrDefault("DB_DRIVER", "sqlite3")
+	source := envOrDefault("DB_SOURCE", "file:todo.db?_fk=1")
+
+	client, err := ent.Open(driver, source)
+	if err != nil {
+		return nil, fmt.Errorf("DBへの接続に失敗しました: %w", err)
+	}
+
+	if err := client.Schema.Create(context.Background()); err != nil {
+		return nil, fmt.Errorf("マイグレーションに失敗しました: %w", err)
+	}
+
+	return &EntUserRepository{client: client}, nil
+}
+
+// FindByEmail はemailに一致するUserを取得します
+func (r *EntUserRepository) FindByEmail(email string) (domain.User, error) {
+	row, err := r.client.User.Query().Where(user.EmailEQ(email)).Only(context.Background())
+	if err != nil {
+		return domain.User{}, errors.New("user not found")
+	}
+	return toDomainUser(row), nil
+}
+
+// FindByID は指定されたIDのUserを取得します
+func (r *EntUserRepository) FindByID(id uint) (domain.User, error) {
+	row, err := r.client.User.Get(context.Background(), int(id))
+	if err != nil {
+		return domain.User{}, errors.New("user not found")
+	}
+	return toDomainUser(row), nil
+}
+
+// Create は新しいUserを作成します。emailが既に登録済みの場合はエラーを返します
+func (r *EntUserRepository) Create(u domain.User) (domain.User, error) {
+	row, err := r.client.User.Create().
+		SetEmail(u.Email).
+		SetPasswordHash(u.PasswordHash).
+		Save(context.Background())
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return domain.User{}, errors.New("email already registered")
+		}
+		return domain.User{}, fmt.Errorf("ユーザーの作成に失敗しました: %w", err)
+	}
+	return toDomainUser(row), nil
+}
+
+// toDomainUser はentが生成したUserモデルをドメインのUser構造体に変換します
+func toDomainUser(row *ent.User) domain.User {
+	return domain.User{
+		ID:           uint(row.ID),
+		Email:        row.Email,
+		PasswordHash: row.PasswordHash,
+		CreatedAt:    row.CreatedAt,
+	}
+}
@@ -0,0 +1,190 @@
+package repository
+
+import (
+	"errors"
+	"io"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/wato787/go-todo/domain"
+	"github.com/wato787/go-todo/infra/eventstore"
+)
+
+// EventSourcedTodoRepository はCreate/Update/Deleteのたびにイベントを追記し、
+// 起動時にそのログをリプレイして状態を再構築するリポジトリです
+type EventSourcedTodoRepository struct {
+	mutex  sync.RWMutex
+	todos  map[uint]domain.Todo
+	nextID uint
+	store  *eventstore.Store
+}
+
+// NewEventSourcedTodoRepository はlogPathのイベントログを使うリポジトリを作成します。
+// 起動時にスナップショットとログをリプレイして状態を復元し、snapshotIntervalが正の場合は
+// 定期的なスナップショット取得を開始します
+func NewEventSourcedTodoRepository(logPath string, snapshotInterval time.Duration) (*EventSourcedTodoRepository, error) {
+	store, err := eventstore.NewStore(logPath)
+	if err != nil {
+		return nil, err
+	}
+
+	repo := &EventSourcedTodoRepository{
+		todos:  make(map[uint]domain.Todo),
+		nextID: 1,
+		store:  store,
+	}
+
+	if snapshot, err := store.LoadSnapshot(); err != nil {
+		return nil, err
+	} else if snapshot != nil {
+		repo.todos = snapshot.Todos
+		repo.nextID = snapshot.NextID
+	}
+
+	if err := store.Replay(repo.apply); err != nil {
+		return nil, err
+	}
+
+	store.StartSnapshotLoop(snapshotInterval, repo.snapshot)
+
+	return repo, nil
+}
+
+// apply はリプレイ時にイベントをメモリ上の状態へ反映します。ログへの再書き込みは行いません
+func (r *EventSourcedTodoRepository) apply(event eventstore.Event) {
+	switch event.Type {
+	case eventstore.EventTodoDeleted:
+		delete(r.todos, event.Todo.ID)
+	default:
+		r.todos[event.Todo.ID] = event.Todo
+	}
+	if event.Todo.ID >= r.nextID {
+		r.nextID = event.Todo.ID + 1
+	}
+}
+
+// snapshot は現在の状態のコピーを取得し、Store.Snapshotでログを切り詰めます。
+// 書き込みロックを取得の間保持し続けることで、状態の取得とログの切り詰めの間にCreate/Update/Deleteが
+// 割り込んでイベントが失われることを防ぎます
+func (r *EventSourcedTodoRepository) snapshot() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	todos := make(map[uint]domain.Todo, len(r.todos))
+	for id, todo := range r.todos {
+		todos[id] = todo
+	}
+	return r.store.Snapshot(todos, r.nextID)
+}
+
+// FindAll はuserIDが所有するTodoのうち、filterの条件で絞り込み・並び替え・ページングした一覧を取得します
+func (r *EventSourcedTodoRepository) FindAll(userID uint, filter domain.TodoFilter) (domain.TodoPage, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	todos := make([]domain.Todo, 0, len(r.todos))
+	for _, todo := range r.todos {
+		if todo.UserID != userID {
+			continue
+		}
+		if filter.Completed != nil && todo.Completed != *filter.Completed {
+			continue
+		}
+		if filter.Keyword != "" && !strings.Contains(strings.ToLower(todo.Title), strings.ToLower(filter.Keyword)) {
+			continue
+		}
+		todos = append(todos, todo)
+	}
+
+	sortTodos(todos, filter.SortBy, filter.SortDesc)
+	total := len(todos)
+	todos = paginate(todos, filter.Limit, filter.Offset)
+
+	return domain.TodoPage{Items: todos, Total: total, Limit: filter.Limit, Offset: filter.Offset}, nil
+}
+
+// FindByID はuserIDが所有する指定されたIDのTodoを取得します
+func (r *EventSourcedTodoRepository) FindByID(userID, id uint) (domain.Todo, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	todo, exists := r.todos[id]
+	if !exists || todo.UserID != userID {
+		return domain.Todo{}, errors.New("todo not found")
+	}
+	return todo, nil
+}
+
+// Create は新しいTodoを作成し、TodoCreatedイベントを追記します
+func (r *EventSourcedTodoRepository) Create(todo domain.Todo) (domain.Todo, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	now := time.Now()
+	todo.ID = r.nextID
+	todo.CreatedAt = now
+	todo.UpdatedAt = now
+	r.todos[todo.ID] = todo
+	r.nextID++
+
+	r.appendEvent(eventstore.EventTodoCreated, todo)
+	return todo, nil
+}
+
+// Update はuserIDが所有する指定されたIDのTodoを更新します。未完了から完了への変化はTodoCompletedイベントとして記録します
+func (r *EventSourcedTodoRepository) Update(userID, id uint, todo domain.Todo) (domain.Todo, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	existing, exists := r.todos[id]
+	if !exists || existing.UserID != userID {
+		return domain.Todo{}, errors.New("todo not found")
+	}
+
+	justCompleted := !existing.Completed && todo.Completed
+
+	if todo.Title != "" {
+		existing.Title = todo.Title
+	}
+	existing.Completed = todo.Completed
+	existing.UpdatedAt = time.Now()
+	r.todos[id] = existing
+
+	eventType := eventstore.EventTodoUpdated
+	if justCompleted {
+		eventType = eventstore.EventTodoCompleted
+	}
+	r.appendEvent(eventType, existing)
+
+	return existing, nil
+}
+
+// Delete はuserIDが所有する指定されたIDのTodoを削除し、TodoDeletedイベントを追記します
+func (r *EventSourcedTodoRepository) Delete(userID, id uint) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	todo, exists := r.todos[id]
+	if !exists || todo.UserID != userID {
+		return errors.New("todo not found")
+	}
+
+	delete(r.todos, id)
+	r.appendEvent(eventstore.EventTodoDeleted, todo)
+	return nil
+}
+
+// StreamEvents はuserIDが所有するTodoのイベントのみをwへ書き出します。domain.EventSourceを満たします
+func (r *EventSourcedTodoRepository) StreamEvents(w io.Writer, userID uint) error {
+	return r.store.StreamTo(w, userID)
+}
+
+// appendEvent はイベントをログに追記します。呼び出し元でr.mutexを保持している前提です
+func (r *EventSourcedTodoRepository) appendEvent(eventType eventstore.EventType, todo domain.Todo) {
+	event := eventstore.Event{Type: eventType, Todo: todo, OccurredAt: time.Now()}
+	if err := r.store.Append(event); err != nil {
+		log.Printf("イベントログへの追記に失敗しました: %v", err)
+	}
+}
@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/wato787/go-todo/domain"
+)
+
+func newEventRepo(t *testing.T) *EventSourcedTodoRepository {
+	t.Helper()
+	logPath := filepath.Join(t.TempDir(), "events.log")
+	repo, err := NewEventSourcedTodoRepository(logPath, 0)
+	if err != nil {
+		t.Fatalf("NewEventSourcedTodoRepository() error = %v", err)
+	}
+	return repo
+}
+
+func TestEventSourcedTodoRepository_所有者以外は404(t *testing.T) {
+	repo := newEventRepo(t)
+	created, err := repo.Create(domain.Todo{UserID: 1, Title: "牛乳を買う"})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	const otherUserID uint = 2
+
+	if _, err := repo.FindByID(otherUserID, created.ID); err == nil {
+		t.Error("FindByID() 他人のTodoに対してerror = nil, wantエラー")
+	}
+	if _, err := repo.Update(otherUserID, created.ID, domain.Todo{Title: "乗っ取り"}); err == nil {
+		t.Error("Update() 他人のTodoに対してerror = nil, wantエラー")
+	}
+	if err := repo.Delete(otherUserID, created.ID); err == nil {
+		t.Error("Delete() 他人のTodoに対してerror = nil, wantエラー")
+	}
+}
+
+func TestEventSourcedTodoRepository_StreamEventsはuserIDで絞り込む(t *testing.T) {
+	repo := newEventRepo(t)
+	if _, err := repo.Create(domain.Todo{UserID: 1, Title: "自分のTodo"}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if _, err := repo.Create(domain.Todo{UserID: 2, Title: "他人のTodo"}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := repo.StreamEvents(&buf, 1); err != nil {
+		t.Fatalf("StreamEvents() error = %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "自分のTodo") {
+		t.Errorf("StreamEvents() = %q, 所有するTodoのイベントを含むべき", out)
+	}
+	if strings.Contains(out, "他人のTodo") {
+		t.Errorf("StreamEvents() = %q, 他人のTodoのイベントを含むべきではない", out)
+	}
+}
+
+func TestEventSourcedTodoRepository_リプレイでuserIDが復元される(t *testing.T) {
+	logPath := filepath.Join(t.TempDir(), "events.log")
+	repo, err := NewEventSourcedTodoRepository(logPath, 0)
+	if err != nil {
+		t.Fatalf("NewEventSourcedTodoRepository() error = %v", err)
+	}
+	created, err := repo.Create(domain.Todo{UserID: 7, Title: "牛乳を買う"})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	reloaded, err := NewEventSourcedTodoRepository(logPath, 0)
+	if err != nil {
+		t.Fatalf("NewEventSourcedTodoRepository() (2回目) error = %v", err)
+	}
+
+	got, err := reloaded.FindByID(7, created.ID)
+	if err != nil {
+		t.Fatalf("FindByID() リプレイ後にerror = %v、所有者は7のままであるべき", err)
+	}
+	if got.UserID != 7 {
+		t.Errorf("UserID = %d, want 7 (リプレイでuser_idが失われている)", got.UserID)
+	}
+}
+
+func TestEventSourcedTodoRepository_snapshotはログを切り詰めて状態を保持する(t *testing.T) {
+	logPath := filepath.Join(t.TempDir(), "events.log")
+	repo, err := NewEventSourcedTodoRepository(logPath, 0)
+	if err != nil {
+		t.Fatalf("NewEventSourcedTodoRepository() error = %v", err)
+	}
+	created, err := repo.Create(domain.Todo{UserID: 1, Title: "牛乳を買う"})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	if err := repo.snapshot(); err != nil {
+		t.Fatalf("snapshot() error = %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := repo.StreamEvents(&buf, 1); err != nil {
+		t.Fatalf("StreamEvents() error = %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("StreamEvents() = %q, snapshot後はログが空であるべき", buf.String())
+	}
+
+	reloaded, err := NewEventSourcedTodoRepository(logPath, 0)
+	if err != nil {
+		t.Fatalf("NewEventSourcedTodoRepository() (2回目) error = %v", err)
+	}
+	got, err := reloaded.FindByID(1, created.ID)
+	if err != nil {
+		t.Fatalf("FindByID() スナップショットからの復元でerror = %v", err)
+	}
+	if got.Title != "牛乳を買う" {
+		t.Errorf("Title = %q, want 牛乳を買う (スナップショットから復元されるべき)", got.Title)
+	}
+}
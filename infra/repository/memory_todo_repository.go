@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/wato787/go-todo/domain"
+)
+
+// InMemoryTodoRepository はメモリ上のmapでTODOを保持するリポジトリです
+type InMemoryTodoRepository struct {
+	mutex  sync.RWMutex
+	todos  map[uint]domain.Todo
+	nextID uint
+}
+
+// NewInMemoryTodoRepository は新しいInMemoryTodoRepositoryインスタンスを作成します
+func NewInMemoryTodoRepository() *InMemoryTodoRepository {
+	return &InMemoryTodoRepository{
+		todos:  make(map[uint]domain.Todo),
+		nextID: 1,
+	}
+}
+
+// FindAll はuserIDが所有するTodoのうち、filterの条件で絞り込み・並び替え・ページングした一覧を取得します
+func (r *InMemoryTodoRepository) FindAll(userID uint, filter domain.TodoFilter) (domain.TodoPage, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	todos := make([]domain.Todo, 0, len(r.todos))
+	for _, todo := range r.todos {
+		if todo.UserID != userID {
+			continue
+		}
+		if filter.Completed != nil && todo.Completed != *filter.Completed {
+			continue
+		}
+		if filter.Keyword != "" && !strings.Contains(strings.ToLower(todo.Title), strings.ToLower(filter.Keyword)) {
+			continue
+		}
+		todos = append(todos, todo)
+	}
+
+	sortTodos(todos, filter.SortBy, filter.SortDesc)
+
+	total := len(todos)
+	todos = paginate(todos, filter.Limit, filter.Offset)
+
+	return domain.TodoPage{
+		Items:  todos,
+		Total:  total,
+		Limit:  filter.Limit,
+		Offset: filter.Offset,
+	}, nil
+}
+
+// sortTodos はsortByで指定されたフィールドでtodosをインプレースに並び替えます
+func sortTodos(todos []domain.Todo, sortBy string, desc bool) {
+	less := func(i, j int) bool {
+		switch sortBy {
+		case "updated_at":
+			return todos[i].UpdatedAt.Before(todos[j].UpdatedAt)
+		case "title":
+			return todos[i].Title < todos[j].Title
+		default:
+			return todos[i].CreatedAt.Before(todos[j].CreatedAt)
+		}
+	}
+
+	sort.Slice(todos, func(i, j int) bool {
+		if desc {
+			return less(j, i)
+		}
+		return less(i, j)
+	})
+}
+
+// paginate はtodosからoffset以降limit件を切り出します。limitが0以下の場合は全件を返します
+func paginate(todos []domain.Todo, limit, offset int) []domain.Todo {
+	if offset < 0 {
+		offset = 0
+	}
+	if offset >= len(todos) {
+		return []domain.Todo{}
+	}
+	todos = todos[offset:]
+
+	if limit > 0 && limit < len(todos) {
+		todos = todos[:limit]
+	}
+	return todos
+}
+
+// FindByID はuserIDが所有する指定されたIDのTodoを取得します
+func (r *InMemoryTodoRepository) FindByID(userID, id uint) (domain.Todo, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	todo, exists := r.todos[id]
+	if !exists || todo.UserID != userID {
+		return domain.Todo{}, errors.New("todo not found")
+	}
+	return todo, nil
+}
+
+// Create は新しいTodoを作成します
+func (r *InMemoryTodoRepository) Create(todo domain.Todo) (domain.Todo, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	now := time.Now()
+	todo.ID = r.nextID
+	todo.CreatedAt = now
+	todo.UpdatedAt = now
+	r.todos[todo.ID] = todo
+	r.nextID++
+
+	return todo, nil
+}
+
+// Update はuserIDが所有する指定されたIDのTodoを更新します
+func (r *InMemoryTodoRepository) Update(userID, id uint, todo domain.Todo) (domain.Todo, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	existing, exists := r.todos[id]
+	if !exists || existing.UserID != userID {
+		return domain.Todo{}, errors.New("todo not found")
+	}
+
+	// 値を更新
+	if todo.Title != "" {
+		existing.Title = todo.Title
+	}
+	existing.Completed = todo.Completed
+	existing.UpdatedAt = time.Now()
+
+	r.todos[id] = existing
+	return existing, nil
+}
+
+// Delete はuserIDが所有する指定されたIDのTodoを削除します
+func (r *InMemoryTodoRepository) Delete(userID, id uint) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	existing, exists := r.todos[id]
+	if !exists || existing.UserID != userID {
+		return errors.New("todo not found")
+	}
+
+	delete(r.todos, id)
+	return nil
+}
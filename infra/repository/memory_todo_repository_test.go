@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/wato787/go-todo/domain"
+)
+
+func TestInMemoryTodoRepository_所有者以外は404(t *testing.T) {
+	repo := NewInMemoryTodoRepository()
+	created, err := repo.Create(domain.Todo{UserID: 1, Title: "牛乳を買う"})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	const otherUserID uint = 2
+
+	if _, err := repo.FindByID(otherUserID, created.ID); err == nil {
+		t.Error("FindByID() 他人のTodoに対してerror = nil, wantエラー")
+	}
+	if _, err := repo.Update(otherUserID, created.ID, domain.Todo{Title: "乗っ取り"}); err == nil {
+		t.Error("Update() 他人のTodoに対してerror = nil, wantエラー")
+	}
+	if err := repo.Delete(otherUserID, created.ID); err == nil {
+		t.Error("Delete() 他人のTodoに対してerror = nil, wantエラー")
+	}
+
+	// 他人の操作が実際には何も変更していないことを確認する
+	got, err := repo.FindByID(1, created.ID)
+	if err != nil {
+		t.Fatalf("FindByID() 所有者からの取得でerror = %v", err)
+	}
+	if got.Title != "牛乳を買う" {
+		t.Errorf("Title = %q, 他人のUpdateで変更されるべきではない", got.Title)
+	}
+}
+
+func TestInMemoryTodoRepository_FindAllはuserIDで絞り込む(t *testing.T) {
+	repo := NewInMemoryTodoRepository()
+	if _, err := repo.Create(domain.Todo{UserID: 1, Title: "自分のTodo"}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if _, err := repo.Create(domain.Todo{UserID: 2, Title: "他人のTodo"}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	page, err := repo.FindAll(1, domain.TodoFilter{})
+	if err != nil {
+		t.Fatalf("FindAll() error = %v", err)
+	}
+	if page.Total != 1 || len(page.Items) != 1 || page.Items[0].Title != "自分のTodo" {
+		t.Errorf("FindAll() = %+v, 自分のTodoのみを1件返すべき", page)
+	}
+}
+
+func TestInMemoryTodoRepository_FindAllのページングと並び替え(t *testing.T) {
+	repo := NewInMemoryTodoRepository()
+	titles := []string{"charlie", "alpha", "bravo"}
+	for _, title := range titles {
+		if _, err := repo.Create(domain.Todo{UserID: 1, Title: title}); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	page, err := repo.FindAll(1, domain.TodoFilter{SortBy: "title", Limit: 2, Offset: 1})
+	if err != nil {
+		t.Fatalf("FindAll() error = %v", err)
+	}
+	if page.Total != 3 {
+		t.Errorf("Total = %d, want 3 (limit/offsetを適用する前の全件数)", page.Total)
+	}
+	if len(page.Items) != 2 {
+		t.Fatalf("Items件数 = %d, want 2", len(page.Items))
+	}
+	gotTitles := []string{page.Items[0].Title, page.Items[1].Title}
+	wantTitles := []string{"bravo", "charlie"}
+	if gotTitles[0] != wantTitles[0] || gotTitles[1] != wantTitles[1] {
+		t.Errorf("Items = %v, want %v (title昇順でoffset=1から2件)", gotTitles, wantTitles)
+	}
+}
+
+func TestInMemoryTodoRepository_FindAllのcompletedフィルタ(t *testing.T) {
+	repo := NewInMemoryTodoRepository()
+	if _, err := repo.Create(domain.Todo{UserID: 1, Title: "未完了"}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	done, err := repo.Create(domain.Todo{UserID: 1, Title: "完了済み"})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if _, err := repo.Update(1, done.ID, domain.Todo{Completed: true}); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	completed := true
+	page, err := repo.FindAll(1, domain.TodoFilter{Completed: &completed})
+	if err != nil {
+		t.Fatalf("FindAll() error = %v", err)
+	}
+	if page.Total != 1 || page.Items[0].Title != "完了済み" {
+		t.Errorf("FindAll() = %+v, 完了済みの1件のみを返すべき", page)
+	}
+}
+
+func TestInMemoryTodoRepository_Updateは空のTitleを無視する(t *testing.T) {
+	repo := NewInMemoryTodoRepository()
+	created, err := repo.Create(domain.Todo{UserID: 1, Title: "元のタイトル"})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	updated, err := repo.Update(1, created.ID, domain.Todo{Completed: true})
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if updated.Title != "元のタイトル" {
+		t.Errorf("Title = %q, want 元のタイトル (空文字なら変更しない)", updated.Title)
+	}
+	if !updated.Completed {
+		t.Error("Completed = false, want true")
+	}
+}
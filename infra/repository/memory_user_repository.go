@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/wato787/go-todo/domain"
+)
+
+// InMemoryUserRepository はメモリ上のmapでUserを保持するリポジトリです
+type InMemoryUserRepository struct {
+	mutex  sync.RWMutex
+	users  map[uint]domain.User
+	nextID uint
+}
+
+// NewInMemoryUserRepository は新しいInMemoryUserRepositoryインスタンスを作成します
+func NewInMemoryUserRepository() *InMemoryUserRepository {
+	return &InMemoryUserRepository{
+		users:  make(map[uint]domain.User),
+		nextID: 1,
+	}
+}
+
+// FindByEmail はemailに一致するUserを取得します
+func (r *InMemoryUserRepository) FindByEmail(email string) (domain.User, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	for _, user := range r.users {
+		if user.Email == email {
+			return user, nil
+		}
+	}
+	return domain.User{}, errors.New("user not found")
+}
+
+// FindByID は指定されたIDのUserを取得します
+func (r *InMemoryUserRepository) FindByID(id uint) (domain.User, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	user, exists := r.users[id]
+	if !exists {
+		return domain.User{}, errors.New("user not found")
+	}
+	return user, nil
+}
+
+// Create は新しいUserを作成します。emailが既に登録済みの場合はエラーを返します
+func (r *InMemoryUserRepository) Create(user domain.User) (domain.User, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, existing := range r.users {
+		if existing.Email == user.Email {
+			return domain.User{}, errors.New("email already registered")
+		}
+	}
+
+	user.ID = r.nextID
+	user.CreatedAt = time.Now()
+	r.users[user.ID] = user
+	r.nextID++
+
+	return user, nil
+}
@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"html/template"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/wato787/go-todo/interface/middleware"
+	"github.com/wato787/go-todo/usecase"
+)
+
+// authCookieMaxAge はAuthCookieNameクッキーの有効期間(秒)です。usecase.AuthService.Loginが発行するJWTの有効期限(24時間)に合わせています
+const authCookieMaxAge = 24 * 60 * 60
+
+// registerRequest は/api/auth/registerのリクエストボディです
+type registerRequest struct {
+	Email    string `json:"email" form:"email" binding:"required,email"`
+	Password string `json:"password" form:"password" binding:"required,min=8"`
+}
+
+// loginRequest は/api/auth/loginのリクエストボディです
+type loginRequest struct {
+	Email    string `json:"email" form:"email" binding:"required,email"`
+	Password string `json:"password" form:"password" binding:"required"`
+}
+
+// AuthHandler はユーザー登録・ログインのHTTPハンドラを定義する構造体です。
+// tmplが設定されている場合、HTMXフロントエンド向けのログイン・登録画面も提供します
+type AuthHandler struct {
+	service usecase.AuthService
+	tmpl    *template.Template
+}
+
+// NewAuthHandler は新しいAuthHandlerインスタンスを作成します
+func NewAuthHandler(service usecase.AuthService) *AuthHandler {
+	return &AuthHandler{service: service}
+}
+
+// WithTemplates はHTMXフロントエンド向けのHTMLテンプレートを設定します
+func (h *AuthHandler) WithTemplates(tmpl *template.Template) *AuthHandler {
+	h.tmpl = tmpl
+	return h
+}
+
+// Register は新しいユーザーを登録するハンドラです
+func (h *AuthHandler) Register(c *gin.Context) {
+	var req registerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, err := h.service.Register(req.Email, req.Password)
+	if err != nil {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, user)
+}
+
+// Login はメールアドレス・パスワードを検証し、成功すればJWTを返すハンドラです
+func (h *AuthHandler) Login(c *gin.Context) {
+	var req loginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	token, err := h.service.Login(req.Email, req.Password)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"token": token})
+}
+
+// RegisterPage はHTMXフロントエンド向けのユーザー登録画面を描画するハンドラです
+func (h *AuthHandler) RegisterPage(c *gin.Context) {
+	c.Header("Content-Type", "text/html; charset=utf-8")
+	_ = h.tmpl.ExecuteTemplate(c.Writer, "register", gin.H{})
+}
+
+// RegisterForm はHTMLフォームからのユーザー登録を受け付け、成功すればログイン画面へリダイレクトするハンドラです
+func (h *AuthHandler) RegisterForm(c *gin.Context) {
+	var req registerRequest
+	if err := c.ShouldBind(&req); err != nil {
+		c.Header("Content-Type", "text/html; charset=utf-8")
+		c.Status(http.StatusBadRequest)
+		_ = h.tmpl.ExecuteTemplate(c.Writer, "register", gin.H{"Error": err.Error()})
+		return
+	}
+
+	if _, err := h.service.Register(req.Email, req.Password); err != nil {
+		c.Header("Content-Type", "text/html; charset=utf-8")
+		c.Status(http.StatusConflict)
+		_ = h.tmpl.ExecuteTemplate(c.Writer, "register", gin.H{"Error": err.Error()})
+		return
+	}
+
+	c.Redirect(http.StatusSeeOther, "/login")
+}
+
+// LoginPage はHTMXフロントエンド向けのログイン画面を描画するハンドラです
+func (h *AuthHandler) LoginPage(c *gin.Context) {
+	c.Header("Content-Type", "text/html; charset=utf-8")
+	_ = h.tmpl.ExecuteTemplate(c.Writer, "login", gin.H{})
+}
+
+// LoginForm はHTMLフォームからのログインを受け付け、成功すればJWTをクッキーに設定してトップページへ
+// リダイレクトするハンドラです
+func (h *AuthHandler) LoginForm(c *gin.Context) {
+	var req loginRequest
+	if err := c.ShouldBind(&req); err != nil {
+		c.Header("Content-Type", "text/html; charset=utf-8")
+		c.Status(http.StatusBadRequest)
+		_ = h.tmpl.ExecuteTemplate(c.Writer, "login", gin.H{"Error": err.Error()})
+		return
+	}
+
+	token, err := h.service.Login(req.Email, req.Password)
+	if err != nil {
+		c.Header("Content-Type", "text/html; charset=utf-8")
+		c.Status(http.StatusUnauthorized)
+		_ = h.tmpl.ExecuteTemplate(c.Writer, "login", gin.H{"Error": err.Error()})
+		return
+	}
+
+	c.SetCookie(middleware.AuthCookieName, token, authCookieMaxAge, "/", "", false, true)
+	c.Redirect(http.StatusSeeOther, "/")
+}
+
+// Logout はAuthCookieNameクッキーを削除し、ログイン画面へリダイレクトするハンドラです
+func (h *AuthHandler) Logout(c *gin.Context) {
+	c.SetCookie(middleware.AuthCookieName, "", -1, "/", "", false, true)
+	c.Redirect(http.StatusSeeOther, "/login")
+}
@@ -0,0 +1,269 @@
+package handler
+
+import (
+	"fmt"
+	"html/template"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/wato787/go-todo/domain"
+	"github.com/wato787/go-todo/interface/middleware"
+	"github.com/wato787/go-todo/usecase"
+)
+
+// TodoHandler は各種HTTPハンドラを定義する構造体です。
+// tmplが設定されている場合、Accept: text/htmlのリクエストにはJSONの代わりにHTMLフラグメントを返します
+type TodoHandler struct {
+	service usecase.TodoService
+	tmpl    *template.Template
+}
+
+// NewTodoHandler は新しいTodoHandlerインスタンスを作成します
+func NewTodoHandler(service usecase.TodoService) *TodoHandler {
+	return &TodoHandler{service: service}
+}
+
+// WithTemplates はHTMXフロントエンド向けのHTMLテンプレートを設定します
+func (h *TodoHandler) WithTemplates(tmpl *template.Template) *TodoHandler {
+	h.tmpl = tmpl
+	return h
+}
+
+// wantsHTML はクライアントがHTMLでの応答を希望しているかどうかを判定します。
+// htmxはリクエストに明示的なAcceptヘッダーを付けず `Accept: */*` のまま送るため、
+// c.NegotiateFormat(gin.MIMEJSON, gin.MIMEHTML) はワイルドカードを最初の候補(JSON)に
+// マッチさせてしまい常にfalseになる。そのためhtmxが付与する HX-Request ヘッダーを優先的に見て、
+// それ以外は明示的にtext/htmlを要求しているAcceptヘッダーだけをHTMLとみなす
+func (h *TodoHandler) wantsHTML(c *gin.Context) bool {
+	if h.tmpl == nil {
+		return false
+	}
+	if c.GetHeader("HX-Request") == "true" {
+		return true
+	}
+	return strings.Contains(c.GetHeader("Accept"), gin.MIMEHTML)
+}
+
+// userID はmiddleware.AuthRequiredがセットした認証済みユーザーIDを取得します
+func userID(c *gin.Context) uint {
+	return c.MustGet(middleware.UserIDKey).(uint)
+}
+
+// GetAllTodos はfilter・sort・paginationのクエリパラメータに従ってTODO一覧を取得するハンドラです
+//
+// 対応クエリパラメータ: keyword, completed, limit, offset, sort (例: -created_at で降順)
+func (h *TodoHandler) GetAllTodos(c *gin.Context) {
+	filter, err := parseTodoFilter(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	page, err := h.service.GetAllTodos(userID(c), filter)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "TODO一覧の取得に失敗しました"})
+		return
+	}
+
+	if h.wantsHTML(c) {
+		c.Header("Content-Type", "text/html; charset=utf-8")
+		_ = h.tmpl.ExecuteTemplate(c.Writer, "todo_list", page.Items)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"items":  page.Items,
+		"total":  page.Total,
+		"limit":  page.Limit,
+		"offset": page.Offset,
+	})
+}
+
+// Index はTodo一覧を表示するHTMXフロントエンドのトップページを描画するハンドラです
+func (h *TodoHandler) Index(c *gin.Context) {
+	page, err := h.service.GetAllTodos(userID(c), domain.TodoFilter{})
+	if err != nil {
+		c.String(http.StatusInternalServerError, "TODO一覧の取得に失敗しました")
+		return
+	}
+
+	c.Header("Content-Type", "text/html; charset=utf-8")
+	_ = h.tmpl.ExecuteTemplate(c.Writer, "index", gin.H{"Todos": page.Items})
+}
+
+// parseTodoFilter はクエリパラメータをdomain.TodoFilterに変換します
+func parseTodoFilter(c *gin.Context) (domain.TodoFilter, error) {
+	filter := domain.TodoFilter{
+		Keyword: c.Query("keyword"),
+	}
+
+	if v := c.Query("completed"); v != "" {
+		completed, err := strconv.ParseBool(v)
+		if err != nil {
+			return domain.TodoFilter{}, fmt.Errorf("completedの値が不正です: %w", err)
+		}
+		filter.Completed = &completed
+	}
+
+	if v := c.Query("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			return domain.TodoFilter{}, fmt.Errorf("limitの値が不正です: %w", err)
+		}
+		filter.Limit = limit
+	}
+
+	if v := c.Query("offset"); v != "" {
+		offset, err := strconv.Atoi(v)
+		if err != nil {
+			return domain.TodoFilter{}, fmt.Errorf("offsetの値が不正です: %w", err)
+		}
+		filter.Offset = offset
+	}
+
+	if sort := c.Query("sort"); sort != "" {
+		filter.SortDesc = strings.HasPrefix(sort, "-")
+		filter.SortBy = strings.TrimPrefix(sort, "-")
+	}
+
+	return filter, nil
+}
+
+// GetTodo は指定されたIDのTODOを取得するハンドラです
+func (h *TodoHandler) GetTodo(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "無効なID形式です"})
+		return
+	}
+
+	todo, err := h.service.GetTodo(userID(c), uint(id))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "TODOが見つかりません"})
+		return
+	}
+
+	c.JSON(http.StatusOK, todo)
+}
+
+// CreateTodo は新しいTODOを作成するハンドラです。JSONボディとHTMLフォームの両方を受け付けます
+func (h *TodoHandler) CreateTodo(c *gin.Context) {
+	var todo domain.Todo
+	if err := c.ShouldBind(&todo); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	createdTodo, err := h.service.CreateTodo(userID(c), todo)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "TODOの作成に失敗しました"})
+		return
+	}
+
+	if h.wantsHTML(c) {
+		c.Header("Content-Type", "text/html; charset=utf-8")
+		c.Status(http.StatusCreated)
+		_ = h.tmpl.ExecuteTemplate(c.Writer, "todo_item", createdTodo)
+		return
+	}
+
+	c.JSON(http.StatusCreated, createdTodo)
+}
+
+// updateTodoRequest はUpdateTodoのリクエストボディです。Titleはdomain.Todoと異なりrequiredにせず、
+// 省略(空文字)の場合はrepository側でタイトルを変更しません。Completedは常に上書きされ、
+// 省略するとfalseとして扱われます
+type updateTodoRequest struct {
+	Title     string `json:"title"`
+	Completed bool   `json:"completed"`
+}
+
+// UpdateTodo は指定されたIDのTODOを更新するハンドラです
+func (h *TodoHandler) UpdateTodo(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "無効なID形式です"})
+		return
+	}
+
+	var req updateTodoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	updatedTodo, err := h.service.UpdateTodo(userID(c), uint(id), domain.Todo{Title: req.Title, Completed: req.Completed})
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "TODOが見つかりません"})
+		return
+	}
+
+	if h.wantsHTML(c) {
+		c.Header("Content-Type", "text/html; charset=utf-8")
+		_ = h.tmpl.ExecuteTemplate(c.Writer, "todo_item", updatedTodo)
+		return
+	}
+
+	c.JSON(http.StatusOK, updatedTodo)
+}
+
+// ToggleTodo は指定されたIDのTODOの完了状態を反転させ、更新後の<li>フラグメントを返すハンドラです。
+// HTMXフロントエンドのチェックボックス操作から呼ばれます
+func (h *TodoHandler) ToggleTodo(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.String(http.StatusBadRequest, "無効なID形式です")
+		return
+	}
+
+	existing, err := h.service.GetTodo(userID(c), uint(id))
+	if err != nil {
+		c.String(http.StatusNotFound, "TODOが見つかりません")
+		return
+	}
+
+	updatedTodo, err := h.service.UpdateTodo(userID(c), uint(id), domain.Todo{Completed: !existing.Completed})
+	if err != nil {
+		c.String(http.StatusNotFound, "TODOが見つかりません")
+		return
+	}
+
+	c.Header("Content-Type", "text/html; charset=utf-8")
+	_ = h.tmpl.ExecuteTemplate(c.Writer, "todo_item", updatedTodo)
+}
+
+// DeleteTodo は指定されたIDのTODOを削除するハンドラです
+func (h *TodoHandler) DeleteTodo(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "無効なID形式です"})
+		return
+	}
+
+	if err := h.service.DeleteTodo(userID(c), uint(id)); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "TODOが見つかりません"})
+		return
+	}
+
+	if h.wantsHTML(c) {
+		// htmxはデフォルトで204を「スワップなし」として扱い<li>が消えないため、
+		// HTML側は200 + 空ボディを返してhx-swap="outerHTML"のスワップを発生させる
+		c.Status(http.StatusOK)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetEvents は呼び出し元が所有するTodoの変更履歴を改行区切りJSON (NDJSON) としてストリームするハンドラです。
+// イベントソーシングに対応していないストレージ構成の場合は501を返します
+func (h *TodoHandler) GetEvents(c *gin.Context) {
+	c.Header("Content-Type", "application/x-ndjson")
+	if err := h.service.StreamEvents(userID(c), c.Writer); err != nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": err.Error()})
+		return
+	}
+}
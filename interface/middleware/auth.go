@@ -0,0 +1,78 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/wato787/go-todo/usecase"
+)
+
+// UserIDKey はAuthRequiredが検証済みのユーザーIDを格納するgin.Contextのキーです
+const UserIDKey = "userID"
+
+// AuthCookieName はHTMXフロントエンドがJWTを保持するために使うクッキー名です。
+// APIクライアントはAuthorizationヘッダーを使うため、このクッキーはブラウザ経由のルート専用です
+const AuthCookieName = "auth_token"
+
+// AuthRequired はリクエストからJWTを取り出しHS256でjwtSecretを使って検証し、成功した場合はcontextに
+// userIDをセットするミドルウェアです。トークンはAuthorization: Bearer <jwt> ヘッダー、または
+// ブラウザ経由のルート向けにAuthCookieNameクッキーから取得します。検証に失敗した場合、HTMLを
+// 希望するリクエストは/loginへリダイレクトし、それ以外は401 JSONで処理を打ち切ります
+func AuthRequired(jwtSecret string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		tokenString := bearerToken(c)
+		if tokenString == "" {
+			unauthorized(c, "認証が必要です")
+			return
+		}
+
+		claims := &usecase.Claims{}
+		token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+			return []byte(jwtSecret), nil
+		}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+		if err != nil || !token.Valid {
+			unauthorized(c, "トークンが無効です")
+			return
+		}
+
+		c.Set(UserIDKey, claims.UserID)
+		c.Next()
+	}
+}
+
+// bearerToken はAuthorizationヘッダー、次いでAuthCookieNameクッキーからJWT文字列を取り出します
+func bearerToken(c *gin.Context) string {
+	header := c.GetHeader("Authorization")
+	if tokenString, ok := strings.CutPrefix(header, "Bearer "); ok && tokenString != "" {
+		return tokenString
+	}
+	if cookie, err := c.Cookie(AuthCookieName); err == nil {
+		return cookie
+	}
+	return ""
+}
+
+// wantsHTML はクライアントがHTMLでの応答を希望しているかどうかを判定します。
+// htmxはリクエストに明示的なAcceptヘッダーを付けず `Accept: */*` のまま送るため、
+// c.NegotiateFormat(gin.MIMEJSON, gin.MIMEHTML) はワイルドカードを最初の候補(JSON)に
+// マッチさせてしまい常にfalseになる。そのためhtmxが付与する HX-Request ヘッダーを優先的に見て、
+// それ以外は明示的にtext/htmlを要求しているAcceptヘッダーだけをHTMLとみなす
+func wantsHTML(c *gin.Context) bool {
+	if c.GetHeader("HX-Request") == "true" {
+		return true
+	}
+	return strings.Contains(c.GetHeader("Accept"), gin.MIMEHTML)
+}
+
+// unauthorized はHTMLを希望するリクエストを/loginへリダイレクトし、それ以外は401 JSONを返して処理を打ち切ります
+func unauthorized(c *gin.Context, message string) {
+	if wantsHTML(c) {
+		c.Redirect(http.StatusSeeOther, "/login")
+		c.Abort()
+		return
+	}
+	c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": message})
+}
@@ -0,0 +1,100 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/wato787/go-todo/usecase"
+)
+
+const testJWTSecret = "test-secret"
+
+func newSignedToken(t *testing.T, userID uint) string {
+	t.Helper()
+	claims := usecase.Claims{
+		UserID: userID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(testJWTSecret))
+	if err != nil {
+		t.Fatalf("テスト用トークンの発行に失敗: %v", err)
+	}
+	return signed
+}
+
+func runAuthRequired(req *http.Request) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	router := gin.New()
+	router.GET("/protected", AuthRequired(testJWTSecret), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestAuthRequired_有効なBearerトークンで通過する(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/protected", nil)
+	req.Header.Set("Authorization", "Bearer "+newSignedToken(t, 1))
+
+	w := runAuthRequired(req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Code = %d, want %d", w.Code, http.StatusOK)
+	}
+}
+
+func TestAuthRequired_有効なクッキーで通過する(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/protected", nil)
+	req.AddCookie(&http.Cookie{Name: AuthCookieName, Value: newSignedToken(t, 1)})
+
+	w := runAuthRequired(req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Code = %d, want %d", w.Code, http.StatusOK)
+	}
+}
+
+func TestAuthRequired_トークンなしのJSONクライアントには401を返す(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/protected", nil)
+	req.Header.Set("Accept", "application/json")
+
+	w := runAuthRequired(req)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("Code = %d, want %d", w.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestAuthRequired_HXRequestには401ではなくloginへリダイレクトする(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/protected", nil)
+	req.Header.Set("HX-Request", "true")
+	req.Header.Set("Accept", "*/*")
+
+	w := runAuthRequired(req)
+
+	if w.Code != http.StatusSeeOther {
+		t.Errorf("Code = %d, want %d", w.Code, http.StatusSeeOther)
+	}
+	if loc := w.Header().Get("Location"); loc != "/login" {
+		t.Errorf("Location = %q, want /login", loc)
+	}
+}
+
+func TestAuthRequired_無効なトークンは拒否される(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/protected", nil)
+	req.Header.Set("Authorization", "Bearer invalid-token")
+
+	w := runAuthRequired(req)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("Code = %d, want %d", w.Code, http.StatusUnauthorized)
+	}
+}
@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/wato787/go-todo/domain"
+)
+
+// tokenTTL は発行するJWTの有効期限です
+const tokenTTL = 24 * time.Hour
+
+// Claims はJWTに格納するカスタムクレームです
+type Claims struct {
+	UserID uint `json:"user_id"`
+	jwt.RegisteredClaims
+}
+
+// AuthService はユーザー登録・ログインに関するユースケースを提供するインターフェースです
+type AuthService interface {
+	Register(email, password string) (domain.User, error)
+	Login(email, password string) (string, error)
+}
+
+// authService はUserRepositoryとJWT秘密鍵を使ってAuthServiceを実装します
+type authService struct {
+	repo      domain.UserRepository
+	jwtSecret []byte
+}
+
+// NewAuthService は新しいAuthServiceインスタンスを作成します
+func NewAuthService(repo domain.UserRepository, jwtSecret string) AuthService {
+	return &authService{repo: repo, jwtSecret: []byte(jwtSecret)}
+}
+
+// Register はemail/passwordで新しいUserを登録します。passwordはbcryptでハッシュ化して保存します
+func (s *authService) Register(email, password string) (domain.User, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return domain.User{}, fmt.Errorf("パスワードのハッシュ化に失敗しました: %w", err)
+	}
+
+	return s.repo.Create(domain.User{Email: email, PasswordHash: string(hash)})
+}
+
+// Login はemail/passwordを検証し、成功すればHS256で署名したJWTを返します
+func (s *authService) Login(email, password string) (string, error) {
+	user, err := s.repo.FindByEmail(email)
+	if err != nil {
+		return "", errors.New("メールアドレスまたはパスワードが違います")
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+		return "", errors.New("メールアドレスまたはパスワードが違います")
+	}
+
+	claims := Claims{
+		UserID: user.ID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(tokenTTL)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	signed, err := token.SignedString(s.jwtSecret)
+	if err != nil {
+		return "", fmt.Errorf("トークンの発行に失敗しました: %w", err)
+	}
+	return signed, nil
+}
@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/wato787/go-todo/domain"
+)
+
+var errNotFound = errors.New("user not found")
+
+func TestAuthService_Registerはパスワードをbcryptでハッシュ化する(t *testing.T) {
+	repo := NewInMemoryUserRepositoryForTest()
+	service := NewAuthService(repo, "test-secret")
+
+	user, err := service.Register("taro@example.com", "password123")
+	if err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	if user.PasswordHash == "" || user.PasswordHash == "password123" {
+		t.Errorf("PasswordHash = %q, 平文のパスワードがそのまま保存されるべきではない", user.PasswordHash)
+	}
+}
+
+func TestAuthService_LoginはJWTを発行する(t *testing.T) {
+	repo := NewInMemoryUserRepositoryForTest()
+	service := NewAuthService(repo, "test-secret")
+
+	if _, err := service.Register("taro@example.com", "password123"); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+
+	token, err := service.Login("taro@example.com", "password123")
+	if err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+
+	claims := &Claims{}
+	parsed, err := jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
+		return []byte("test-secret"), nil
+	})
+	if err != nil || !parsed.Valid {
+		t.Fatalf("発行されたトークンの検証に失敗: err=%v valid=%v", err, parsed.Valid)
+	}
+	if claims.UserID != 1 {
+		t.Errorf("claims.UserID = %d, want 1", claims.UserID)
+	}
+}
+
+func TestAuthService_Loginはパスワードが違う場合エラーを返す(t *testing.T) {
+	repo := NewInMemoryUserRepositoryForTest()
+	service := NewAuthService(repo, "test-secret")
+
+	if _, err := service.Register("taro@example.com", "password123"); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+
+	if _, err := service.Login("taro@example.com", "違うパスワード"); err == nil {
+		t.Error("Login() error = nil, wantエラー")
+	}
+}
+
+func TestAuthService_Loginは存在しないemailの場合エラーを返す(t *testing.T) {
+	repo := NewInMemoryUserRepositoryForTest()
+	service := NewAuthService(repo, "test-secret")
+
+	if _, err := service.Login("nobody@example.com", "password123"); err == nil {
+		t.Error("Login() error = nil, wantエラー")
+	}
+}
+
+// inMemoryUserRepositoryForTest はdomain.UserRepositoryのテスト用インスタンスです。
+// infra/repositoryに依存せずusecaseパッケージ内で完結させるための簡易実装です
+type inMemoryUserRepositoryForTest struct {
+	users  map[uint]domain.User
+	nextID uint
+}
+
+// NewInMemoryUserRepositoryForTest は新しいinMemoryUserRepositoryForTestインスタンスを作成します
+func NewInMemoryUserRepositoryForTest() *inMemoryUserRepositoryForTest {
+	return &inMemoryUserRepositoryForTest{users: make(map[uint]domain.User), nextID: 1}
+}
+
+func (r *inMemoryUserRepositoryForTest) FindByEmail(email string) (domain.User, error) {
+	for _, user := range r.users {
+		if user.Email == email {
+			return user, nil
+		}
+	}
+	return domain.User{}, errNotFound
+}
+
+func (r *inMemoryUserRepositoryForTest) FindByID(id uint) (domain.User, error) {
+	user, exists := r.users[id]
+	if !exists {
+		return domain.User{}, errNotFound
+	}
+	return user, nil
+}
+
+func (r *inMemoryUserRepositoryForTest) Create(user domain.User) (domain.User, error) {
+	user.ID = r.nextID
+	r.users[user.ID] = user
+	r.nextID++
+	return user, nil
+}
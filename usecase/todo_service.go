@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"errors"
+	"io"
+
+	"github.com/wato787/go-todo/domain"
+)
+
+// TodoService はTODOに関するユースケースを提供するインターフェースです。
+// 全ての操作は呼び出し元のuserIDで所有者を絞り込みます。
+type TodoService interface {
+	GetAllTodos(userID uint, filter domain.TodoFilter) (domain.TodoPage, error)
+	GetTodo(userID, id uint) (domain.Todo, error)
+	CreateTodo(userID uint, todo domain.Todo) (domain.Todo, error)
+	UpdateTodo(userID, id uint, todo domain.Todo) (domain.Todo, error)
+	DeleteTodo(userID, id uint) error
+	StreamEvents(userID uint, w io.Writer) error
+}
+
+// todoService はTodoRepositoryを使ってTodoServiceを実装します
+type todoService struct {
+	repo domain.TodoRepository
+}
+
+// NewTodoService は新しいTodoServiceインスタンスを作成します
+func NewTodoService(repo domain.TodoRepository) TodoService {
+	return &todoService{repo: repo}
+}
+
+// GetAllTodos はuserIDが所有するTodoのうち、filterの条件で絞り込み・並び替え・ページングした一覧を取得します
+func (s *todoService) GetAllTodos(userID uint, filter domain.TodoFilter) (domain.TodoPage, error) {
+	return s.repo.FindAll(userID, filter)
+}
+
+// GetTodo はuserIDが所有する指定されたIDのTodoを取得します
+func (s *todoService) GetTodo(userID, id uint) (domain.Todo, error) {
+	return s.repo.FindByID(userID, id)
+}
+
+// CreateTodo はuserID所有の新しいTodoを作成します
+func (s *todoService) CreateTodo(userID uint, todo domain.Todo) (domain.Todo, error) {
+	todo.UserID = userID
+	return s.repo.Create(todo)
+}
+
+// UpdateTodo はuserIDが所有する指定されたIDのTodoを更新します
+func (s *todoService) UpdateTodo(userID, id uint, todo domain.Todo) (domain.Todo, error) {
+	return s.repo.Update(userID, id, todo)
+}
+
+// DeleteTodo はuserIDが所有する指定されたIDのTodoを削除します
+func (s *todoService) DeleteTodo(userID, id uint) error {
+	return s.repo.Delete(userID, id)
+}
+
+// StreamEvents はリポジトリがdomain.EventSourceを実装している場合に、userIDが所有する変更履歴のみをwへ
+// ストリームします。イベントソーシングを使わないストレージ実装では対応していないエラーを返します
+func (s *todoService) StreamEvents(userID uint, w io.Writer) error {
+	source, ok := s.repo.(domain.EventSource)
+	if !ok {
+		return errors.New("このストレージではイベントの購読に対応していません")
+	}
+	return source.StreamEvents(w, userID)
+}
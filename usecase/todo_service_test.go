@@ -0,0 +1,184 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/wato787/go-todo/domain"
+)
+
+const testUserID uint = 1
+
+// mockTodoRepository はテスト用のdomain.TodoRepository実装です
+type mockTodoRepository struct {
+	findAllFunc  func(userID uint, filter domain.TodoFilter) (domain.TodoPage, error)
+	findByIDFunc func(userID, id uint) (domain.Todo, error)
+	createFunc   func(todo domain.Todo) (domain.Todo, error)
+	updateFunc   func(userID, id uint, todo domain.Todo) (domain.Todo, error)
+	deleteFunc   func(userID, id uint) error
+}
+
+func (m *mockTodoRepository) FindAll(userID uint, filter domain.TodoFilter) (domain.TodoPage, error) {
+	return m.findAllFunc(userID, filter)
+}
+
+func (m *mockTodoRepository) FindByID(userID, id uint) (domain.Todo, error) {
+	return m.findByIDFunc(userID, id)
+}
+
+func (m *mockTodoRepository) Create(todo domain.Todo) (domain.Todo, error) {
+	return m.createFunc(todo)
+}
+
+func (m *mockTodoRepository) Update(userID, id uint, todo domain.Todo) (domain.Todo, error) {
+	return m.updateFunc(userID, id, todo)
+}
+
+func (m *mockTodoRepository) Delete(userID, id uint) error {
+	return m.deleteFunc(userID, id)
+}
+
+func TestTodoService_GetTodo(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      uint
+		repo    *mockTodoRepository
+		want    domain.Todo
+		wantErr bool
+	}{
+		{
+			name: "存在するIDの場合はTodoを返す",
+			id:   1,
+			repo: &mockTodoRepository{
+				findByIDFunc: func(userID, id uint) (domain.Todo, error) {
+					return domain.Todo{ID: 1, UserID: testUserID, Title: "牛乳を買う"}, nil
+				},
+			},
+			want: domain.Todo{ID: 1, UserID: testUserID, Title: "牛乳を買う"},
+		},
+		{
+			name: "存在しないIDの場合はエラーを返す",
+			id:   99,
+			repo: &mockTodoRepository{
+				findByIDFunc: func(userID, id uint) (domain.Todo, error) {
+					return domain.Todo{}, errors.New("todo not found")
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewTodoService(tt.repo)
+
+			got, err := service.GetTodo(testUserID, tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetTodo() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("GetTodo() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTodoService_GetAllTodos(t *testing.T) {
+	wantPage := domain.TodoPage{
+		Items: []domain.Todo{{ID: 1, UserID: testUserID, Title: "牛乳を買う"}},
+		Total: 1,
+		Limit: 20,
+	}
+	var gotUserID uint
+	var gotFilter domain.TodoFilter
+	repo := &mockTodoRepository{
+		findAllFunc: func(userID uint, filter domain.TodoFilter) (domain.TodoPage, error) {
+			gotUserID = userID
+			gotFilter = filter
+			return wantPage, nil
+		},
+	}
+	service := NewTodoService(repo)
+
+	filter := domain.TodoFilter{Keyword: "牛乳", Limit: 20}
+	got, err := service.GetAllTodos(testUserID, filter)
+	if err != nil {
+		t.Fatalf("GetAllTodos() error = %v", err)
+	}
+	if got.Total != wantPage.Total || len(got.Items) != len(wantPage.Items) {
+		t.Errorf("GetAllTodos() = %v, want %v", got, wantPage)
+	}
+	if gotUserID != testUserID {
+		t.Errorf("repositoryに渡されたuserID = %v, want %v", gotUserID, testUserID)
+	}
+	if gotFilter != filter {
+		t.Errorf("repositoryに渡されたfilter = %v, want %v", gotFilter, filter)
+	}
+}
+
+func TestTodoService_CreateTodo(t *testing.T) {
+	repo := &mockTodoRepository{
+		createFunc: func(todo domain.Todo) (domain.Todo, error) {
+			todo.ID = 1
+			return todo, nil
+		},
+	}
+	service := NewTodoService(repo)
+
+	got, err := service.CreateTodo(testUserID, domain.Todo{Title: "掃除する"})
+	if err != nil {
+		t.Fatalf("CreateTodo() error = %v", err)
+	}
+
+	want := domain.Todo{ID: 1, UserID: testUserID, Title: "掃除する"}
+	if got != want {
+		t.Errorf("CreateTodo() = %v, want %v", got, want)
+	}
+}
+
+func TestTodoService_CreateTodo_エラー(t *testing.T) {
+	wantErr := errors.New("db error")
+	repo := &mockTodoRepository{
+		createFunc: func(todo domain.Todo) (domain.Todo, error) {
+			return domain.Todo{}, wantErr
+		},
+	}
+	service := NewTodoService(repo)
+
+	if _, err := service.CreateTodo(testUserID, domain.Todo{Title: "掃除する"}); err == nil {
+		t.Fatal("CreateTodo() error = nil, want error")
+	}
+}
+
+func TestTodoService_DeleteTodo(t *testing.T) {
+	tests := []struct {
+		name    string
+		repo    *mockTodoRepository
+		wantErr bool
+	}{
+		{
+			name: "削除に成功する",
+			repo: &mockTodoRepository{
+				deleteFunc: func(userID, id uint) error { return nil },
+			},
+		},
+		{
+			name: "存在しないIDの場合はエラーを返す",
+			repo: &mockTodoRepository{
+				deleteFunc: func(userID, id uint) error { return errors.New("todo not found") },
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewTodoService(tt.repo)
+
+			err := service.DeleteTodo(testUserID, 1)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DeleteTodo() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}